@@ -0,0 +1,114 @@
+package go_validator
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// cachedField is the pre-compiled, per-type metadata for one struct field.
+// Building it involves exactly the work Struct used to redo on every call:
+// reading the tag, checking exportedness, expanding aliases, parsing the tag
+// grammar, and resolving each operation's bound/set/Func. Once cached,
+// validateFields only reflects to read the field's current value and calls
+// straight into the compiled closures.
+type cachedField struct {
+	index      int
+	name       string
+	hasTag     bool
+	unexported bool // untagged and unexported: skipped, never recursed into
+
+	containerGroups [][]compiledOp
+	elementGroups   [][]compiledOp
+	diving          bool
+}
+
+// cachedStruct is the compiled field layout of one struct type.
+type cachedStruct struct {
+	fields []cachedField
+}
+
+// cacheFor returns the compiled layout of typ, building it once per
+// (Validate, type) pair. v's alias table and any RegisterValidation
+// overrides of len/min/max/in are consulted at build time, so both must be
+// registered before the first Struct call that uses them.
+func (v *Validate) cacheFor(typ reflect.Type) (*cachedStruct, error) {
+	if cs, ok := v.cache.Load(typ); ok {
+		return cs.(*cachedStruct), nil
+	}
+
+	cs, err := v.buildCache(typ)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := v.cache.LoadOrStore(typ, cs)
+	return actual.(*cachedStruct), nil
+}
+
+func (v *Validate) buildCache(typ reflect.Type) (*cachedStruct, error) {
+	cs := &cachedStruct{fields: make([]cachedField, typ.NumField())}
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		cf := cachedField{index: i, name: field.Name}
+
+		query, has := field.Tag.Lookup(validateTag)
+		switch {
+		case has && !field.IsExported():
+			return nil, fmt.Errorf("field %q: %w", field.Name, ErrValidateForUnexportedFields)
+		case has:
+			cf.hasTag = true
+
+			expanded, err := v.resolveAliases(query, 0, map[string]struct{}{})
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", field.Name, err)
+			}
+			groups, err := parseTag(expanded, v.isBareOperation)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", field.Name, err)
+			}
+			containerGroups, elementGroups, diving := splitOnDive(groups)
+
+			cf.containerGroups, err = v.compileGroups(containerGroups, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", field.Name, err)
+			}
+
+			cf.diving = diving
+			if diving {
+				elemType := baseType(field.Type)
+				switch elemType.Kind() {
+				case reflect.Slice, reflect.Array, reflect.Map:
+				default:
+					return nil, fmt.Errorf("field %q: %w: dive on non-container kind (%s)", field.Name, ErrUnsupportedOperationForType, elemType.Kind())
+				}
+
+				cf.elementGroups, err = v.compileGroups(elementGroups, elemType.Elem())
+				if err != nil {
+					return nil, fmt.Errorf("field %q: %w", field.Name, err)
+				}
+			}
+		case !field.IsExported():
+			cf.unexported = true
+		}
+
+		cs.fields[i] = cf
+	}
+	return cs, nil
+}
+
+// compileGroups compiles every operation of every orGroup in groups against
+// fieldType, preserving the AND-of-OR structure.
+func (v *Validate) compileGroups(groups []orGroup, fieldType reflect.Type) ([][]compiledOp, error) {
+	compiled := make([][]compiledOp, len(groups))
+	for i, g := range groups {
+		ops := make([]compiledOp, len(g))
+		for j, op := range g {
+			co, err := v.compileOp(op, fieldType)
+			if err != nil {
+				return nil, err
+			}
+			ops[j] = co
+		}
+		compiled[i] = ops
+	}
+	return compiled, nil
+}
@@ -0,0 +1,55 @@
+package go_validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type diveOnScalarStruct struct {
+	Age int `validate:"dive,min:3"`
+}
+
+func TestDiveOnNonContainerFieldErrors(t *testing.T) {
+	v := New()
+	err := v.Struct(diveOnScalarStruct{Age: 5})
+	if err == nil {
+		t.Fatal("expected an error for dive on a non-container field")
+	}
+	if !errors.Is(err, ErrUnsupportedOperationForType) {
+		t.Fatalf("expected ErrUnsupportedOperationForType, got: %v", err)
+	}
+}
+
+type taggedUnexportedStruct struct {
+	name string `validate:"min:3"` //nolint:unused
+}
+
+func TestTaggedUnexportedFieldErrors(t *testing.T) {
+	v := New()
+	if err := v.Struct(taggedUnexportedStruct{}); !errors.Is(err, ErrValidateForUnexportedFields) {
+		t.Fatalf("expected ErrValidateForUnexportedFields, got: %v", err)
+	}
+}
+
+func TestStructFieldLayoutIsCachedPerType(t *testing.T) {
+	type s struct {
+		Name string `validate:"min:3"`
+	}
+	v := New()
+
+	if err := v.Struct(s{Name: "abc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cs1, err := v.cacheFor(reflect.TypeOf(s{}))
+	if err != nil {
+		t.Fatalf("cacheFor: %v", err)
+	}
+	cs2, err := v.cacheFor(reflect.TypeOf(s{}))
+	if err != nil {
+		t.Fatalf("cacheFor: %v", err)
+	}
+	if cs1 != cs2 {
+		t.Fatal("expected the same cached layout to be reused across calls")
+	}
+}
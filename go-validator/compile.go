@@ -0,0 +1,221 @@
+package go_validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// compiledOp is a single operation with its arguments already resolved once,
+// at cache-build time: len/min/max/in have their numeric bound or value set
+// parsed ahead of time instead of on every Struct call, and everything else
+// (required, eqfield/nefield/gtfield, custom registrations) has its Func
+// looked up once and its raw string args captured in the closure, so the hot
+// path is a single call with no registry lookup or string parsing left in it.
+type compiledOp struct {
+	name string
+	run  func(ctx Context) error
+}
+
+// isBuiltinName reports whether name is one of the operations compileOp can
+// fast-path by dispatching straight to a Validating[T] implementation,
+// rather than through the registry.
+func isBuiltinName(name string) bool {
+	switch name {
+	case lenOperation, minOperation, maxOperation, inOperation:
+		return true
+	default:
+		return false
+	}
+}
+
+// compileOp resolves a single parsed operation against fieldType (the static
+// Go type of the field or element it will run on) into a compiledOp. Builtin
+// operations that haven't been overridden via RegisterValidation are
+// compiled straight to their Validating[T] implementation; everything else
+// goes through the registry, once, here, rather than on every call.
+func (v *Validate) compileOp(op operation, fieldType reflect.Type) (compiledOp, error) {
+	if isBuiltinName(op.name) && !v.isOverridden(op.name) {
+		return compileBuiltinOp(op, fieldType)
+	}
+
+	fn, ok := v.lookup(op.name)
+	if !ok {
+		return compiledOp{}, fmt.Errorf("%w: (%s)", ErrUnsupportedOperation, op.name)
+	}
+	args := op.args
+	return compiledOp{name: op.name, run: func(ctx Context) error { return fn(ctx, args) }}, nil
+}
+
+// baseType strips any pointer indirection off t.
+func baseType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// derefValue strips any pointer indirection off v, the runtime value a
+// compiled operation is about to inspect. ok is false when a nil pointer is
+// found at any level, meaning the operation should be skipped rather than
+// run - the same nil-pointer-skip convention validateNested and
+// compareFields already follow for struct recursion and cross-field
+// comparisons.
+func derefValue(v reflect.Value) (rv reflect.Value, ok bool) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+// compileBuiltinOp picks the Validating[T] implementation that matches
+// fieldType's kind and compiles op against it. A slice, array or map is
+// measured by its own length, matching how validateElements applies dive'd
+// operations to elements instead.
+func compileBuiltinOp(op operation, fieldType reflect.Type) (compiledOp, error) {
+	t := baseType(fieldType)
+	if t == timeType {
+		return compileGeneric(op, TimeValidating(time.Time{}).Parse, func(v reflect.Value) Validating[time.Time] {
+			return TimeValidating(v.Interface().(time.Time))
+		}, true)
+	}
+
+	switch {
+	case isSignedInt(t.Kind()):
+		return compileGeneric(op, IntValidating(0).Parse, func(v reflect.Value) Validating[int64] {
+			return IntValidating(v.Int())
+		}, false)
+	case isUnsignedInt(t.Kind()):
+		return compileGeneric(op, UintValidating(0).Parse, func(v reflect.Value) Validating[uint64] {
+			return UintValidating(v.Uint())
+		}, false)
+	case isFloat(t.Kind()):
+		return compileGeneric(op, FloatValidating(0).Parse, func(v reflect.Value) Validating[float64] {
+			return FloatValidating(v.Float())
+		}, false)
+	case t.Kind() == reflect.Bool:
+		return compileGeneric(op, BoolValidating(false).Parse, func(v reflect.Value) Validating[bool] {
+			return BoolValidating(v.Bool())
+		}, false)
+	case t.Kind() == reflect.String:
+		return compileGeneric(op, StringValidating("").Parse, func(v reflect.Value) Validating[string] {
+			return StringValidating(v.String())
+		}, false)
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map:
+		if op.name == lenOperation && len(op.args) == 1 {
+			if bound, e := strconv.Atoi(op.args[0]); e == nil && bound >= 0 {
+				return compiledOp{name: op.name, run: func(ctx Context) error {
+					rv, ok := derefValue(ctx.Value)
+					if !ok {
+						return nil
+					}
+					if rv.Len() != bound {
+						return fmt.Errorf("%w: len == %d != %d", ErrLenValidationFailed, rv.Len(), bound)
+					}
+					return nil
+				}}, nil
+			}
+		}
+		return compileGeneric(op, IntValidating(0).Parse, func(v reflect.Value) Validating[int64] {
+			return IntValidating(v.Len())
+		}, false)
+	default:
+		return compiledOp{}, fmt.Errorf("%w: (%s)", ErrUnsupportedType, t.Kind())
+	}
+}
+
+// compileGeneric parses op's arguments once using parse and the min/max
+// bound rule isTime calls for, and returns a compiledOp whose run closure
+// only has to turn a runtime reflect.Value into a Validating[T] via wrap and
+// call straight into it - no further parsing.
+func compileGeneric[T SupportedTypes](op operation, parse func(string) (T, error), wrap func(reflect.Value) Validating[T], isTime bool) (compiledOp, error) {
+	switch op.name {
+	case lenOperation:
+		if len(op.args) != 1 {
+			return compiledOp{}, fmt.Errorf("%w: too many arguments (%d) for operation \"%s\"", ErrInvalidValidatorSyntax, len(op.args), op.name)
+		}
+		bound, e := strconv.Atoi(op.args[0])
+		if e != nil {
+			return compiledOp{}, fmt.Errorf("%w: can't parse int argument \"%s\" %w", ErrInvalidValidatorSyntax, op.args[0], e)
+		}
+		if bound < 0 {
+			return compiledOp{}, fmt.Errorf("%w: negative value for len operation (%d)", ErrInvalidValidatorSyntax, bound)
+		}
+		return compiledOp{name: op.name, run: func(ctx Context) error {
+			rv, ok := derefValue(ctx.Value)
+			if !ok {
+				return nil
+			}
+			return wrap(rv).Len(bound)
+		}}, nil
+
+	case minOperation, maxOperation:
+		if len(op.args) != 1 {
+			return compiledOp{}, fmt.Errorf("%w: too many arguments (%d) for operation \"%s\"", ErrInvalidValidatorSyntax, len(op.args), op.name)
+		}
+		bound, e := parseMinMaxBound(isTime, op.args[0])
+		if e != nil {
+			return compiledOp{}, e
+		}
+		if op.name == minOperation {
+			return compiledOp{name: op.name, run: func(ctx Context) error {
+				rv, ok := derefValue(ctx.Value)
+				if !ok {
+					return nil
+				}
+				return wrap(rv).Min(bound)
+			}}, nil
+		}
+		return compiledOp{name: op.name, run: func(ctx Context) error {
+			rv, ok := derefValue(ctx.Value)
+			if !ok {
+				return nil
+			}
+			return wrap(rv).Max(bound)
+		}}, nil
+
+	case inOperation:
+		mp := map[T]struct{}{}
+		for _, s := range op.args {
+			x, e := parse(s)
+			if e != nil {
+				return compiledOp{}, fmt.Errorf("%w: can't parse argument \"%s\" %w", ErrInvalidValidatorSyntax, s, e)
+			}
+			mp[x] = struct{}{}
+		}
+		return compiledOp{name: op.name, run: func(ctx Context) error {
+			rv, ok := derefValue(ctx.Value)
+			if !ok {
+				return nil
+			}
+			return wrap(rv).In(mp)
+		}}, nil
+
+	default:
+		return compiledOp{}, fmt.Errorf("%w: (%s)", ErrUnsupportedOperation, op.name)
+	}
+}
+
+// parseMinMaxBound turns a min/max tag argument into the float64 bound
+// Min/Max expect. Every type but time.Time takes a plain (possibly
+// fractional) number, so a float field can be bounded by e.g.
+// `validate:"max:99.9"`; a time.Time bound is an RFC3339 timestamp,
+// converted to Unix seconds.
+func parseMinMaxBound(isTime bool, s string) (float64, error) {
+	if isTime {
+		t, e := time.Parse(time.RFC3339, s)
+		if e != nil {
+			return 0, fmt.Errorf("%w: can't parse RFC3339 time \"%s\" %w", ErrInvalidValidatorSyntax, s, e)
+		}
+		return float64(t.Unix()), nil
+	}
+	f, e := strconv.ParseFloat(s, 64)
+	if e != nil {
+		return 0, fmt.Errorf("%w: can't parse numeric argument \"%s\" %w", ErrInvalidValidatorSyntax, s, e)
+	}
+	return f, nil
+}
@@ -0,0 +1,79 @@
+package go_validator
+
+import (
+	"testing"
+	"time"
+)
+
+type pointerFieldsStruct struct {
+	Age  *int    `validate:"min:3,max:10"`
+	Name *string `validate:"min:3"`
+}
+
+func TestPointerFieldsAreDereferencedBeforeValidating(t *testing.T) {
+	v := New()
+	age, name := 5, "gopher"
+
+	if err := v.Struct(pointerFieldsStruct{Age: &age, Name: &name}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tooYoung := 1
+	if err := v.Struct(pointerFieldsStruct{Age: &tooYoung, Name: &name}); err == nil {
+		t.Fatal("expected min validation failure on the dereferenced *int")
+	}
+
+	tooShort := "go"
+	if err := v.Struct(pointerFieldsStruct{Age: &age, Name: &tooShort}); err == nil {
+		t.Fatal("expected min validation failure on the dereferenced *string")
+	}
+}
+
+func TestNilPointerSkipsValidation(t *testing.T) {
+	v := New()
+	if err := v.Struct(pointerFieldsStruct{}); err != nil {
+		t.Fatalf("nil pointers should be skipped, got: %v", err)
+	}
+}
+
+func TestCompileBuiltinOpAcrossKinds(t *testing.T) {
+	type kinds struct {
+		I   int       `validate:"min:1,max:10"`
+		U   uint      `validate:"min:1,max:10"`
+		F   float64   `validate:"min:1.5,max:9.5"`
+		B   bool      `validate:"in:true"`
+		S   string    `validate:"len:6"`
+		T   time.Time `validate:"min:2020-01-01T00:00:00Z"`
+		Tgs []int     `validate:"min:1,max:3"`
+	}
+
+	v := New()
+	ok := kinds{
+		I: 5, U: 5, F: 3, B: true, S: "gopher",
+		T:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tgs: []int{1, 2},
+	}
+	if err := v.Struct(ok); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bad := ok
+	bad.I = 0
+	if err := v.Struct(bad); err == nil {
+		t.Fatal("expected min validation failure on int field")
+	}
+}
+
+func TestDiveAppliesOperationsToElements(t *testing.T) {
+	type s struct {
+		Tags []string `validate:"dive,min:2"`
+	}
+	v := New()
+
+	if err := v.Struct(s{Tags: []string{"aa", "bbb"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := v.Struct(s{Tags: []string{"aa", "b"}}); err == nil {
+		t.Fatal("expected min validation failure on a dived element")
+	}
+}
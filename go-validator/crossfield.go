@@ -0,0 +1,124 @@
+package go_validator
+
+import (
+	stdcmp "cmp"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// compareFields orders a against the named field of parent, after
+// dereferencing any pointers, returning -1/0/1 like cmp.Compare. It supports
+// every kind the builtin operations do: all integer and float widths, bool,
+// string and time.Time.
+//
+// comparable is false, with no error, when either side is a nil pointer -
+// like every other operation, a nil pointer has nothing to compare and is
+// skipped rather than failed or panicked on.
+func compareFields(a reflect.Value, parent reflect.Value, fieldName string) (cmp int, comparable bool, err error) {
+	b := parent.FieldByName(fieldName)
+	if !b.IsValid() {
+		return 0, false, fmt.Errorf("%w: unknown field %q", ErrInvalidValidatorSyntax, fieldName)
+	}
+
+	for a.Kind() == reflect.Pointer {
+		if a.IsNil() {
+			return 0, false, nil
+		}
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Pointer {
+		if b.IsNil() {
+			return 0, false, nil
+		}
+		b = b.Elem()
+	}
+
+	switch {
+	case a.Type() == timeType && b.Type() == timeType:
+		ta, tb := a.Interface().(time.Time), b.Interface().(time.Time)
+		switch {
+		case ta.Before(tb):
+			return -1, true, nil
+		case ta.After(tb):
+			return 1, true, nil
+		default:
+			return 0, true, nil
+		}
+	case isSignedInt(a.Kind()) && isSignedInt(b.Kind()):
+		return stdcmp.Compare(a.Int(), b.Int()), true, nil
+	case isUnsignedInt(a.Kind()) && isUnsignedInt(b.Kind()):
+		return stdcmp.Compare(a.Uint(), b.Uint()), true, nil
+	case isFloat(a.Kind()) && isFloat(b.Kind()):
+		return stdcmp.Compare(a.Float(), b.Float()), true, nil
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		return stdcmp.Compare(a.String(), b.String()), true, nil
+	case a.Kind() == reflect.Bool && b.Kind() == reflect.Bool:
+		return compareBool(a.Bool(), b.Bool()), true, nil
+	default:
+		return 0, false, fmt.Errorf("%w: (%s vs %s)", ErrUnsupportedType, a.Kind(), b.Kind())
+	}
+}
+
+func isSignedInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUnsignedInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFloat(k reflect.Kind) bool {
+	switch k {
+	case reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareBool orders false before true; bool has no operator support for
+// cmp.Compare, so it gets its own one-liner.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// crossFieldOperation builds a Func for a cross-field operation (eqfield,
+// nefield, gtfield, ...) that compares ctx.Value against the field named by
+// its single argument, found on ctx.Parent, and fails with failErr unless
+// the comparison result ok accepts.
+func crossFieldOperation(failErr error, ok func(cmp int) bool) Func {
+	return func(ctx Context, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: cross-field operation takes exactly one field name", ErrInvalidValidatorSyntax)
+		}
+		cmp, comparable, err := compareFields(ctx.Value, ctx.Parent, args[0])
+		if err != nil {
+			return err
+		}
+		if !comparable {
+			return nil
+		}
+		if !ok(cmp) {
+			return fmt.Errorf("%w: against field %q", failErr, args[0])
+		}
+		return nil
+	}
+}
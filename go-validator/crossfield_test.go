@@ -0,0 +1,56 @@
+package go_validator
+
+import "testing"
+
+type crossFieldStruct struct {
+	Password        string
+	PasswordConfirm string `validate:"eqfield:Password"`
+	Alt             string `validate:"nefield:Password"`
+	Max             int
+	Min             int `validate:"gtfield:Max"`
+}
+
+func TestCrossFieldOperations(t *testing.T) {
+	v := New()
+	ok := crossFieldStruct{
+		Password: "hunter2", PasswordConfirm: "hunter2", Alt: "different",
+		Max: 1, Min: 2,
+	}
+	if err := v.Struct(ok); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bad := ok
+	bad.PasswordConfirm = "mismatch"
+	if err := v.Struct(bad); err == nil {
+		t.Fatal("expected eqfield validation failure")
+	}
+}
+
+type crossFieldPointerStruct struct {
+	Max *int
+	Min *int `validate:"gtfield:Max"`
+}
+
+func TestCrossFieldNilPointerIsSkipped(t *testing.T) {
+	v := New()
+	if err := v.Struct(crossFieldPointerStruct{}); err != nil {
+		t.Fatalf("nil pointers on either side should be skipped, got: %v", err)
+	}
+
+	maxVal := 5
+	if err := v.Struct(crossFieldPointerStruct{Max: &maxVal}); err != nil {
+		t.Fatalf("a nil Min should still be skipped, got: %v", err)
+	}
+}
+
+type crossFieldUnknownFieldStruct struct {
+	A string `validate:"eqfield:DoesNotExist"`
+}
+
+func TestCrossFieldUnknownFieldErrors(t *testing.T) {
+	v := New()
+	if err := v.Struct(crossFieldUnknownFieldStruct{A: "x"}); err == nil {
+		t.Fatal("expected an error for a cross-field reference to a nonexistent field")
+	}
+}
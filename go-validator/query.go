@@ -0,0 +1,148 @@
+package go_validator
+
+import "fmt"
+
+// operation is a single named validation call with its raw string arguments,
+// e.g. "min:3" parses to operation{name: "min", args: []string{"3"}}.
+type operation struct {
+	name string
+	args []string
+}
+
+// orGroup is a set of operations of which at least one must succeed ("|" in
+// the tag grammar). A group with a single operation is the common case.
+type orGroup []operation
+
+// diveOperation is a bare keyword (no arguments) that switches the
+// operations following it from applying to a slice/map field itself to
+// applying to each of its elements/values, e.g. "min:1,dive,min:3" on
+// []string requires at least one element, each of length >= 3.
+const diveOperation = "dive"
+
+// isBareKeyword reports whether part is a zero-argument pseudo-operation
+// that's allowed to appear without a ":" in the tag grammar.
+func isBareKeyword(part string) bool {
+	return part == diveOperation || part == requiredOperation
+}
+
+// bareOperationChecker reports whether a colon-less tag segment names a
+// valid operation with no arguments, rather than a dangling argument.
+// parseTag and resolveAliases both need this: the built-in bare keywords
+// (dive, required) are always valid this way, and so is any custom
+// operation a caller has registered via RegisterValidation and uses bare,
+// e.g. validate:"email".
+type bareOperationChecker func(name string) bool
+
+// splitOnDive splits groups on the first bare "dive" entry, returning the
+// groups that apply to the container itself and the groups that apply to
+// its elements. found is false if there's no dive in groups.
+func splitOnDive(groups []orGroup) (container, elements []orGroup, found bool) {
+	for i, g := range groups {
+		if len(g) == 1 && g[0].name == diveOperation {
+			return groups[:i], groups[i+1:], true
+		}
+	}
+	return groups, nil, false
+}
+
+// parseTag parses the grammar accepted by a validate tag: operations joined
+// by "," are AND'd together at the top level, and operations joined by "|"
+// within one of those top-level entries form an orGroup, e.g.
+//
+//	"min:3,max:20"        -> [{min 3}] AND [{max 20}]
+//	"in:foo,bar|regexp:^x" -> [{in [foo bar]} OR {regexp [^x]}]
+//
+// Because an operation's own arguments are also comma-separated (as in
+// in:foo,bar), a comma only starts a new operation when what follows it
+// contains a ":"; otherwise it's treated as another argument of the
+// operation currently being built. isBareOp decides whether a colon-less
+// segment is itself a zero-argument operation (see bareOperationChecker) or
+// a dangling argument.
+func parseTag(query string, isBareOp bareOperationChecker) ([]orGroup, error) {
+	if query == "" {
+		return nil, fmt.Errorf("%w: empty tag", ErrInvalidValidatorSyntax)
+	}
+
+	parts, delims := splitOnAndOr(query)
+
+	var groups []orGroup
+	var cur orGroup
+	var op *operation
+
+	closeOp := func() {
+		if op != nil {
+			cur = append(cur, *op)
+			op = nil
+		}
+	}
+	closeGroup := func() {
+		closeOp()
+		if len(cur) > 0 {
+			groups = append(groups, cur)
+			cur = nil
+		}
+	}
+
+	for i, part := range parts {
+		name, arg, hasColon := cutOperation(part)
+		if !hasColon {
+			if isBareOp(part) {
+				if i > 0 && delims[i-1] == ',' {
+					closeGroup()
+				} else {
+					closeOp()
+				}
+				op = &operation{name: part}
+				if part == diveOperation {
+					closeGroup()
+				}
+				continue
+			}
+			if op == nil {
+				return nil, fmt.Errorf("%w: dangling argument %q", ErrInvalidValidatorSyntax, part)
+			}
+			op.args = append(op.args, part)
+			continue
+		}
+		if arg == "" {
+			return nil, fmt.Errorf("%w: zero arguments for operation is provided", ErrInvalidValidatorSyntax)
+		}
+
+		if i > 0 && delims[i-1] == ',' {
+			closeGroup()
+		} else {
+			closeOp()
+		}
+		op = &operation{name: name, args: []string{arg}}
+	}
+	closeGroup()
+
+	return groups, nil
+}
+
+// splitOnAndOr splits query on its top-level "," and "|" delimiters,
+// returning the text between them alongside the delimiter that preceded
+// each part after the first (delims[i] is the delimiter before parts[i+1]).
+func splitOnAndOr(query string) (parts []string, delims []byte) {
+	start := 0
+	for i := range len(query) {
+		if query[i] == ',' || query[i] == '|' {
+			parts = append(parts, query[start:i])
+			delims = append(delims, query[i])
+			start = i + 1
+		}
+	}
+	return append(parts, query[start:]), delims
+}
+
+// cutOperation splits part into an operation name and its first argument on
+// the first ":". hasColon is false when part has no colon, meaning it's a
+// continuation argument of the operation preceding it rather than a new one.
+func cutOperation(part string) (name, arg string, hasColon bool) {
+	for i := range len(part) {
+		if part[i] == ':' {
+			return part[:i], part[i+1:], true
+		}
+	}
+	return "", part, false
+}
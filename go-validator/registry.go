@@ -0,0 +1,393 @@
+package go_validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// maxAliasDepth bounds how many times an alias may expand into another alias
+// before Struct gives up, so a typo'd alias chain fails fast
+// instead of recursing until the stack blows up.
+const maxAliasDepth = 10
+
+// Context carries everything an operation might need beyond the raw tag
+// arguments: the value being validated, the struct it lives on (for
+// cross-field operations like eqfield), the root struct originally passed to
+// Struct (for future cross-struct references), and its dotted namespace
+// path. Operations that only look at the value being validated can ignore
+// everything but Value.
+type Context struct {
+	Value     reflect.Value
+	Top       reflect.Value
+	Parent    reflect.Value
+	Namespace string
+}
+
+// Func is a single validation operation. It receives the context of the
+// value being validated and the raw (string) arguments taken from the tag,
+// e.g. for `validate:"min:3"` args would be []string{"3"}.
+type Func func(ctx Context, args []string) error
+
+// Validate holds a registry of named validation operations. The zero value
+// is not usable; construct one with New, which pre-registers the built-in
+// required/eqfield/nefield/gtfield operations. len/min/max/in are handled
+// without going through the registry at all - see compileOp in compile.go -
+// unless RegisterValidation is used to override one of them.
+//
+// A *Validate is safe for concurrent use once registration is done: register
+// operations during init and call Struct from as many goroutines as needed.
+type Validate struct {
+	mu         sync.RWMutex
+	registry   map[string]Func
+	overridden map[string]struct{} // builtin names (len/min/max/in) re-registered via RegisterValidation
+	aliases    map[string]string
+	cache      sync.Map // reflect.Type -> *cachedStruct
+}
+
+// New returns a Validate with the built-in cross-field/required operations
+// registered. len, min, max and in have no registry entry by default -
+// compileOp dispatches them straight to their Validating[T] implementation
+// unless RegisterValidation has overridden the name.
+func New() *Validate {
+	v := &Validate{
+		registry:   make(map[string]Func, 4),
+		overridden: make(map[string]struct{}),
+		aliases:    make(map[string]string),
+	}
+	v.registry[requiredOperation] = requiredFunc
+	v.registry[eqFieldOperation] = crossFieldOperation(ErrEqFieldValidationFailed, func(cmp int) bool { return cmp == 0 })
+	v.registry[neFieldOperation] = crossFieldOperation(ErrNeFieldValidationFailed, func(cmp int) bool { return cmp != 0 })
+	v.registry[gtFieldOperation] = crossFieldOperation(ErrGtFieldValidationFailed, func(cmp int) bool { return cmp > 0 })
+	return v
+}
+
+// RegisterValidation adds a custom validation operation under tag, making it
+// usable as validate:"tag:args". Registering under a name that already
+// exists (including a built-in one) overwrites it. Overriding one of the
+// fast-pathed builtins (len/min/max/in) must happen before the first Struct
+// call that uses it, the same constraint RegisterAlias already has, since
+// compileOp decides whether to fast-path a field at cache-build time.
+func (v *Validate) RegisterValidation(tag string, fn Func) error {
+	if tag == "" {
+		return fmt.Errorf("%w: empty tag", ErrInvalidValidatorSyntax)
+	}
+	if fn == nil {
+		return fmt.Errorf("%w: nil function for tag %q", ErrInvalidValidatorSyntax, tag)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.registry[tag] = fn
+	if isBuiltinName(tag) {
+		v.overridden[tag] = struct{}{}
+	}
+	return nil
+}
+
+// RegisterAlias registers alias as shorthand for tags, the content of a
+// validate tag it should expand to, e.g.
+//
+//	v.RegisterAlias("iscolor", "in:red,green,blue|regexp:^#[0-9a-f]{6}$")
+//	type T struct { C string `validate:"iscolor"` }
+//
+// Aliases may reference other aliases; resolveAliases expands them recursively.
+func (v *Validate) RegisterAlias(alias, tags string) error {
+	if alias == "" || tags == "" {
+		return fmt.Errorf("%w: empty alias or tags", ErrInvalidValidatorSyntax)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.aliases[alias] = tags
+	return nil
+}
+
+// resolveAliases expands every top-level segment of query (split on "," and
+// "|", same as the tag grammar itself) that names a registered alias,
+// rejoining the result with the original delimiters. A segment is only a
+// candidate alias name if it opens a new entry: one with a ":" is already a
+// real operation, a bare keyword (dive, required) is left untouched rather
+// than looked up as an alias - otherwise a field tagged validate:"required"
+// would fail with "unknown alias \"required\"" - a colon-less segment naming
+// a registered operation (e.g. a custom op registered via
+// RegisterValidation and used bare, as in validate:"email") is left
+// untouched the same way, since it's already a real operation and not an
+// alias reference - and, mirroring parseTag, a colon-less segment following
+// an operation that has one is just another of that operation's
+// comma-separated arguments (e.g. the "green"/"blue" in
+// "in:red,green,blue"), not an alias reference either.
+func (v *Validate) resolveAliases(query string, depth int, seen map[string]struct{}) (string, error) {
+	parts, delims := splitOnAndOr(query)
+
+	expanded := make([]string, len(parts))
+	continuesOp := false
+	for i, part := range parts {
+		_, _, hasColon := cutOperation(part)
+		switch {
+		case hasColon:
+			expanded[i] = part
+			continuesOp = true
+			continue
+		case v.isBareOperation(part):
+			expanded[i] = part
+			continuesOp = false
+			continue
+		case continuesOp:
+			expanded[i] = part
+			continue
+		}
+
+		branchSeen := make(map[string]struct{}, len(seen)+1)
+		for k := range seen {
+			branchSeen[k] = struct{}{}
+		}
+		resolved, err := v.resolveAlias(part, depth, branchSeen)
+		if err != nil {
+			return "", err
+		}
+		expanded[i] = resolved
+		continuesOp = false
+	}
+
+	var b strings.Builder
+	for i, part := range expanded {
+		b.WriteString(part)
+		if i < len(delims) {
+			b.WriteByte(delims[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// resolveAlias expands name, a single alias name (never a bare keyword or a
+// real operation - resolveAliases has already filtered those out),
+// recursively, failing on unknown aliases, alias cycles, and expansion
+// chains deeper than maxAliasDepth.
+func (v *Validate) resolveAlias(name string, depth int, seen map[string]struct{}) (string, error) {
+	if depth >= maxAliasDepth {
+		return "", fmt.Errorf("%w: alias expansion exceeded max depth (%d)", ErrInvalidValidatorSyntax, maxAliasDepth)
+	}
+	if _, ok := seen[name]; ok {
+		return "", fmt.Errorf("%w: alias cycle detected at %q", ErrInvalidValidatorSyntax, name)
+	}
+
+	v.mu.RLock()
+	tags, ok := v.aliases[name]
+	v.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("%w: unknown alias %q", ErrInvalidValidatorSyntax, name)
+	}
+
+	seen[name] = struct{}{}
+	return v.resolveAliases(tags, depth+1, seen)
+}
+
+func (v *Validate) lookup(name string) (Func, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	fn, ok := v.registry[name]
+	return fn, ok
+}
+
+// isBareOperation reports whether name is usable without a ":" in a
+// validate tag: either one of the built-in bare keywords (dive, required),
+// or an operation registered via RegisterValidation and meant to be used
+// with no arguments, e.g. validate:"email". See bareOperationChecker in
+// query.go.
+func (v *Validate) isBareOperation(name string) bool {
+	if isBareKeyword(name) {
+		return true
+	}
+	_, ok := v.lookup(name)
+	return ok
+}
+
+// isOverridden reports whether name, one of the fast-pathed builtins
+// (len/min/max/in), has been re-registered via RegisterValidation and so
+// must go through the registry instead of compileBuiltinOp.
+func (v *Validate) isOverridden(name string) bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	_, ok := v.overridden[name]
+	return ok
+}
+
+// Struct validates s, which must be a struct, against its `validate` tags,
+// recursing into nested structs (and pointers to structs).
+func (v *Validate) Struct(s any) error {
+	rv := reflect.ValueOf(s)
+	if rv.Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	return v.validateFields(rv, rv, "")
+}
+
+// validateFields validates every tagged field of sv and recurses into
+// nested structs, prefixing errors with namespace, the dotted path from the
+// root struct passed to Struct (e.g. "Address.Zip"). top is the root struct
+// value, carried unchanged through the recursion so cross-struct operations
+// can reach it. The field layout and parsed tags for sv's type are looked up
+// from v's cache instead of being re-walked on every call.
+func (v *Validate) validateFields(sv, top reflect.Value, namespace string) error {
+	cs, err := v.cacheFor(sv.Type())
+	if err != nil {
+		return err
+	}
+
+	var es []error
+	for _, cf := range cs.fields {
+		fv := sv.Field(cf.index)
+		childNamespace := joinNamespace(namespace, cf.name)
+		ctx := Context{Value: fv, Top: top, Parent: sv, Namespace: childNamespace}
+
+		if cf.hasTag {
+			if e := v.validateCached(ctx, cf); e != nil {
+				es = append(es, NewValidationError(e, childNamespace))
+			}
+		}
+
+		if !cf.unexported {
+			if e := v.validateNested(fv, top, childNamespace); e != nil {
+				es = append(es, e)
+			}
+		}
+	}
+
+	if len(es) == 0 {
+		return nil
+	}
+	return errors.Join(es...)
+}
+
+// validateCached dispatches the already-parsed groups of cf against ctx.
+func (v *Validate) validateCached(ctx Context, cf cachedField) error {
+	if e := v.validateGroups(ctx, cf.containerGroups); e != nil {
+		return e
+	}
+	if !cf.diving {
+		return nil
+	}
+	return v.validateElements(ctx, cf.elementGroups)
+}
+
+// validateNested recurses into fv if it's a struct or a non-nil pointer to
+// one; anything else (including a nil pointer) is left alone. time.Time is a
+// struct too, but its fields aren't user fields, so it's excluded like any
+// other non-struct leaf value.
+func (v *Validate) validateNested(fv, top reflect.Value, namespace string) error {
+	ev := fv
+	for ev.Kind() == reflect.Pointer {
+		if ev.IsNil() {
+			return nil
+		}
+		ev = ev.Elem()
+	}
+	if ev.Kind() != reflect.Struct || ev.Type() == timeType {
+		return nil
+	}
+	return v.validateFields(ev, top, namespace)
+}
+
+func joinNamespace(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
+
+// validateGroups runs every group (AND'd together) against ctx, collecting
+// every failure instead of stopping at the first.
+func (v *Validate) validateGroups(ctx Context, groups [][]compiledOp) error {
+	var failures []error
+	for _, group := range groups {
+		if e := v.validateGroup(ctx, group); e != nil {
+			failures = append(failures, e)
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return errors.Join(failures...)
+}
+
+// validateGroup runs the operations of a group (an OR'd-together orGroup,
+// already compiled), succeeding as soon as one branch passes. If every
+// branch fails, it reports all of them.
+func (v *Validate) validateGroup(ctx Context, group []compiledOp) error {
+	var branchErrs []error
+	for _, op := range group {
+		if e := op.run(ctx); e != nil {
+			branchErrs = append(branchErrs, e)
+			continue
+		}
+		return nil
+	}
+	return errors.Join(branchErrs...)
+}
+
+// validateElements applies groups to each element of a slice/array or each
+// value of a map, for the `dive` modifier.
+func (v *Validate) validateElements(ctx Context, groups [][]compiledOp) error {
+	var es []error
+	switch ctx.Value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range ctx.Value.Len() {
+			elemCtx := ctx
+			elemCtx.Value = ctx.Value.Index(i)
+			if e := v.validateGroups(elemCtx, groups); e != nil {
+				es = append(es, fmt.Errorf("[%d]: %w", i, e))
+			}
+		}
+	case reflect.Map:
+		iter := ctx.Value.MapRange()
+		for iter.Next() {
+			elemCtx := ctx
+			elemCtx.Value = iter.Value()
+			if e := v.validateGroups(elemCtx, groups); e != nil {
+				es = append(es, fmt.Errorf("[%v]: %w", iter.Key().Interface(), e))
+			}
+		}
+	default:
+		return fmt.Errorf("%w: dive on non-container kind (%s)", ErrUnsupportedOperationForType, ctx.Value.Kind())
+	}
+
+	if len(es) == 0 {
+		return nil
+	}
+	return errors.Join(es...)
+}
+
+// requiredFunc is the built-in "required" operation: it fails on the zero
+// value of whatever type it's applied to, including a nil pointer, which
+// every other operation treats as "skip validation".
+func requiredFunc(ctx Context, _ []string) error {
+	if ctx.Value.IsZero() {
+		return fmt.Errorf("%w", ErrRequiredValidationFailed)
+	}
+	return nil
+}
+
+// defaultValidate is used by the package-level RegisterValidation/Struct
+// helpers, mirroring how go-playground/validator exposes a default instance.
+var defaultValidate = New()
+
+// RegisterValidation registers fn under tag on the default Validate instance.
+func RegisterValidation(tag string, fn Func) error {
+	return defaultValidate.RegisterValidation(tag, fn)
+}
+
+// RegisterAlias registers alias as shorthand for tags on the default
+// Validate instance.
+func RegisterAlias(alias, tags string) error {
+	return defaultValidate.RegisterAlias(alias, tags)
+}
+
+// Struct validates s against its `validate` tags using the default Validate
+// instance. It supersedes the old package-level Validate(s) function, whose
+// name now belongs to the Validate type.
+func Struct(s any) error {
+	return defaultValidate.Struct(s)
+}
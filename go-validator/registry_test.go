@@ -0,0 +1,150 @@
+package go_validator
+
+import (
+	"errors"
+	"testing"
+)
+
+type customOpStruct struct {
+	Code string `validate:"oneofcustom:a,b,c"`
+}
+
+func TestRegisterValidationCustomOp(t *testing.T) {
+	v := New()
+	if err := v.RegisterValidation("oneofcustom", func(ctx Context, args []string) error {
+		for _, a := range args {
+			if ctx.Value.String() == a {
+				return nil
+			}
+		}
+		return errors.New("not one of the allowed values")
+	}); err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	if err := v.Struct(customOpStruct{Code: "b"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := v.Struct(customOpStruct{Code: "z"}); err == nil {
+		t.Fatal("expected error for value outside the custom set")
+	}
+}
+
+type bareCustomOpStruct struct {
+	Email string `validate:"email"`
+}
+
+func TestBareRegisteredOperationIsNotTreatedAsAlias(t *testing.T) {
+	v := New()
+	called := false
+	if err := v.RegisterValidation("email", func(ctx Context, args []string) error {
+		called = true
+		return nil
+	}); err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	if err := v.Struct(bareCustomOpStruct{Email: "anything"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("registered \"email\" operation was never invoked")
+	}
+}
+
+func TestRegisterAliasExpansion(t *testing.T) {
+	v := New()
+	if err := v.RegisterAlias("shortname", "min:2,max:5"); err != nil {
+		t.Fatalf("RegisterAlias: %v", err)
+	}
+	type s struct {
+		Name string `validate:"shortname"`
+	}
+
+	if err := v.Struct(s{Name: "abc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := v.Struct(s{Name: "a"}); err == nil {
+		t.Fatal("expected min validation failure through the alias")
+	}
+}
+
+func TestRegisterAliasComposedWithBareKeyword(t *testing.T) {
+	v := New()
+	if err := v.RegisterAlias("shortname", "min:2,max:5"); err != nil {
+		t.Fatalf("RegisterAlias: %v", err)
+	}
+	type s struct {
+		Name string `validate:"required,shortname"`
+	}
+
+	if err := v.Struct(s{Name: "abc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := v.Struct(s{Name: ""}); err == nil {
+		t.Fatal("expected required validation failure")
+	}
+}
+
+func TestDirectMultiArgInIsNotTreatedAsAliasChain(t *testing.T) {
+	v := New()
+	type s struct {
+		Color string `validate:"in:red,green,blue"`
+	}
+
+	if err := v.Struct(s{Color: "green"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := v.Struct(s{Color: "purple"}); err == nil {
+		t.Fatal("expected in validation failure")
+	}
+}
+
+func TestUnknownAliasFails(t *testing.T) {
+	v := New()
+	type s struct {
+		X string `validate:"totallyUnknownAlias"`
+	}
+
+	err := v.Struct(s{X: "x"})
+	if err == nil {
+		t.Fatal("expected unknown alias error")
+	}
+	if !errors.Is(err, ErrInvalidValidatorSyntax) {
+		t.Fatalf("expected ErrInvalidValidatorSyntax, got: %v", err)
+	}
+}
+
+func TestAliasCycleDetected(t *testing.T) {
+	v := New()
+	if err := v.RegisterAlias("a", "b"); err != nil {
+		t.Fatalf("RegisterAlias: %v", err)
+	}
+	if err := v.RegisterAlias("b", "a"); err != nil {
+		t.Fatalf("RegisterAlias: %v", err)
+	}
+	type s struct {
+		X string `validate:"a"`
+	}
+
+	if err := v.Struct(s{X: "x"}); err == nil {
+		t.Fatal("expected alias cycle error")
+	}
+}
+
+func TestRequiredFailsOnZeroAndNilPointer(t *testing.T) {
+	type s struct {
+		Name string  `validate:"required"`
+		Age  *int    `validate:"required"`
+	}
+	v := New()
+
+	if err := v.Struct(s{}); err == nil {
+		t.Fatal("expected required validation failures on zero string and nil pointer")
+	}
+
+	age := 0
+	if err := v.Struct(s{Name: "x", Age: &age}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -3,49 +3,185 @@ package go_validator
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 type SupportedTypes interface {
-	int | string
+	int64 | uint64 | float64 | bool | string | time.Time
 }
 
+// Validating is the per-type implementation of the operations an operation
+// of a validate tag can ask for. Min and Max take a float64 bound (not an
+// int) so a float64 field can be constrained to a non-integer value, e.g.
+// `validate:"max:99.9"`; the other implementors widen what they compare
+// (their value, a string's length, a time's Unix seconds) to float64 before
+// comparing, and BoolValidating rejects min/max as unsupported.
 type Validating[T SupportedTypes] interface {
 	Len(int) error
-	Min(int) error
-	Max(int) error
+	Min(bound float64) error
+	Max(bound float64) error
 	In(map[T]struct{}) error
 	Parse(string) (T, error)
 }
 
-type IntValidating int
+type IntValidating int64
 
 func (IntValidating) Len(int) error {
 	return fmt.Errorf("%w: operation \"len\" on type int", ErrUnsupportedOperationForType)
 }
 
-func (i IntValidating) Min(bound int) error {
-	if int(i) < bound {
-		return fmt.Errorf("%w: %d < %d", ErrMinValidationFailed, i, bound)
+func (i IntValidating) Min(bound float64) error {
+	if float64(i) < bound {
+		return fmt.Errorf("%w: %d < %g", ErrMinValidationFailed, i, bound)
 	}
 	return nil
 }
 
-func (i IntValidating) Max(bound int) error {
-	if int(i) > bound {
-		return fmt.Errorf("%w: %d > %d", ErrMaxValidationFailed, i, bound)
+func (i IntValidating) Max(bound float64) error {
+	if float64(i) > bound {
+		return fmt.Errorf("%w: %d > %g", ErrMaxValidationFailed, i, bound)
 	}
 	return nil
 }
 
-func (i IntValidating) In(v map[int]struct{}) error {
-	if _, has := v[int(i)]; !has {
+func (i IntValidating) In(v map[int64]struct{}) error {
+	if _, has := v[int64(i)]; !has {
 		return fmt.Errorf("%w: %d is not in %s", ErrInValidationFailed, i, printMap(v))
 	}
 	return nil
 }
 
-func (i IntValidating) Parse(s string) (int, error) {
-	return strconv.Atoi(s)
+func (i IntValidating) Parse(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// UintValidating backs every unsigned integer kind (reflect.Value.Uint
+// already widens all of them to uint64, so one wrapper covers uint through
+// uint64).
+type UintValidating uint64
+
+func (UintValidating) Len(int) error {
+	return fmt.Errorf("%w: operation \"len\" on type uint", ErrUnsupportedOperationForType)
+}
+
+func (u UintValidating) Min(bound float64) error {
+	if float64(u) < bound {
+		return fmt.Errorf("%w: %d < %g", ErrMinValidationFailed, u, bound)
+	}
+	return nil
+}
+
+func (u UintValidating) Max(bound float64) error {
+	if float64(u) > bound {
+		return fmt.Errorf("%w: %d > %g", ErrMaxValidationFailed, u, bound)
+	}
+	return nil
+}
+
+func (u UintValidating) In(v map[uint64]struct{}) error {
+	if _, has := v[uint64(u)]; !has {
+		return fmt.Errorf("%w: %d is not in %s", ErrInValidationFailed, u, printMap(v))
+	}
+	return nil
+}
+
+func (u UintValidating) Parse(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// FloatValidating backs both float32 and float64 (reflect.Value.Float widens
+// either to float64).
+type FloatValidating float64
+
+func (FloatValidating) Len(int) error {
+	return fmt.Errorf("%w: operation \"len\" on type float", ErrUnsupportedOperationForType)
+}
+
+func (f FloatValidating) Min(bound float64) error {
+	if float64(f) < bound {
+		return fmt.Errorf("%w: %g < %g", ErrMinValidationFailed, f, bound)
+	}
+	return nil
+}
+
+func (f FloatValidating) Max(bound float64) error {
+	if float64(f) > bound {
+		return fmt.Errorf("%w: %g > %g", ErrMaxValidationFailed, f, bound)
+	}
+	return nil
+}
+
+func (f FloatValidating) In(v map[float64]struct{}) error {
+	if _, has := v[float64(f)]; !has {
+		return fmt.Errorf("%w: %g is not in %s", ErrInValidationFailed, f, printMap(v))
+	}
+	return nil
+}
+
+func (f FloatValidating) Parse(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// BoolValidating has no ordering, so len/min/max are unsupported; only
+// required and in (e.g. `validate:"in:true"`) make sense for it.
+type BoolValidating bool
+
+func (BoolValidating) Len(int) error {
+	return fmt.Errorf("%w: operation \"len\" on type bool", ErrUnsupportedOperationForType)
+}
+
+func (BoolValidating) Min(float64) error {
+	return fmt.Errorf("%w: operation \"min\" on type bool", ErrUnsupportedOperationForType)
+}
+
+func (BoolValidating) Max(float64) error {
+	return fmt.Errorf("%w: operation \"max\" on type bool", ErrUnsupportedOperationForType)
+}
+
+func (b BoolValidating) In(v map[bool]struct{}) error {
+	if _, has := v[bool(b)]; !has {
+		return fmt.Errorf("%w: %t is not in %s", ErrInValidationFailed, b, printMap(v))
+	}
+	return nil
+}
+
+func (BoolValidating) Parse(s string) (bool, error) {
+	return strconv.ParseBool(s)
+}
+
+// TimeValidating treats min/max bounds as Unix seconds; the caller (see
+// parseMinMaxBound in compile.go) is responsible for turning an RFC3339 tag
+// argument into that bound before calling in here. len is meaningless for a
+// timestamp.
+type TimeValidating time.Time
+
+func (TimeValidating) Len(int) error {
+	return fmt.Errorf("%w: operation \"len\" on type time.Time", ErrUnsupportedOperationForType)
+}
+
+func (t TimeValidating) Min(bound float64) error {
+	if float64(time.Time(t).Unix()) < bound {
+		return fmt.Errorf("%w: %s is before %s", ErrMinValidationFailed, time.Time(t), time.Unix(int64(bound), 0).UTC())
+	}
+	return nil
+}
+
+func (t TimeValidating) Max(bound float64) error {
+	if float64(time.Time(t).Unix()) > bound {
+		return fmt.Errorf("%w: %s is after %s", ErrMaxValidationFailed, time.Time(t), time.Unix(int64(bound), 0).UTC())
+	}
+	return nil
+}
+
+func (t TimeValidating) In(v map[time.Time]struct{}) error {
+	if _, has := v[time.Time(t)]; !has {
+		return fmt.Errorf("%w: %s is not in %s", ErrInValidationFailed, time.Time(t), printMap(v))
+	}
+	return nil
+}
+
+func (TimeValidating) Parse(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
 }
 
 type StringValidating string
@@ -57,16 +193,16 @@ func (s StringValidating) Len(bound int) error {
 	return nil
 }
 
-func (s StringValidating) Min(bound int) error {
-	if len(s) < bound {
-		return fmt.Errorf("%w: len(%s) == %d < %d", ErrMinValidationFailed, s, len(s), bound)
+func (s StringValidating) Min(bound float64) error {
+	if float64(len(s)) < bound {
+		return fmt.Errorf("%w: len(%s) == %d < %g", ErrMinValidationFailed, s, len(s), bound)
 	}
 	return nil
 }
 
-func (s StringValidating) Max(bound int) error {
-	if len(s) > bound {
-		return fmt.Errorf("%w: len(%s) == %d > %d", ErrMaxValidationFailed, s, len(s), bound)
+func (s StringValidating) Max(bound float64) error {
+	if float64(len(s)) > bound {
+		return fmt.Errorf("%w: len(%s) == %d > %g", ErrMaxValidationFailed, s, len(s), bound)
 	}
 	return nil
 }